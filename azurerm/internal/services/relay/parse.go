@@ -0,0 +1,61 @@
+package relay
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AuthorizationRuleId represents either a Relay Namespace-scoped or a Relay
+// HybridConnection-scoped authorization rule. HybridConnectionName is empty
+// for a Namespace-scoped rule.
+type AuthorizationRuleId struct {
+	ResourceGroup        string
+	NamespaceName        string
+	HybridConnectionName string
+	Name                 string
+}
+
+// ParseAuthorizationRuleID parses the Resource ID of a Relay Namespace or
+// HybridConnection authorization rule, e.g.:
+//   .../namespaces/{namespace}/authorizationRules/{rule}
+//   .../namespaces/{namespace}/hybridConnections/{relay}/authorizationRules/{rule}
+func ParseAuthorizationRuleID(input string) (*AuthorizationRuleId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Relay Authorization Rule ID %q: %s", input, err)
+	}
+
+	namespaceName, ok := id.Path["namespaces"]
+	if !ok || namespaceName == "" {
+		return nil, fmt.Errorf("%q is not a valid Relay Authorization Rule ID: missing `namespaces` segment", input)
+	}
+
+	ruleName, ok := id.Path["authorizationRules"]
+	if !ok || ruleName == "" {
+		return nil, fmt.Errorf("%q is not a valid Relay Authorization Rule ID: missing `authorizationRules` segment", input)
+	}
+
+	return &AuthorizationRuleId{
+		ResourceGroup:        id.ResourceGroup,
+		NamespaceName:        namespaceName,
+		HybridConnectionName: id.Path["hybridConnections"],
+		Name:                 ruleName,
+	}, nil
+}
+
+// ValidateAuthorizationRuleID validates that the given value is a well-formed
+// Relay Namespace or HybridConnection authorization rule Resource ID.
+func ValidateAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseAuthorizationRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("can not parse %q as a Relay Authorization Rule ID: %s", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,102 @@
+package relay
+
+import "testing"
+
+func TestParseAuthorizationRuleID(t *testing.T) {
+	cases := []struct {
+		Name                     string
+		Input                    string
+		ExpectError              bool
+		ExpectedNamespaceName    string
+		ExpectedHybridConnection string
+		ExpectedRuleName         string
+	}{
+		{
+			Name:        "Empty",
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Name:        "No Authorization Rules Segment",
+			Input:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Relay/namespaces/namespace1",
+			ExpectError: true,
+		},
+		{
+			Name:                  "Namespace Scoped Rule",
+			Input:                 "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Relay/namespaces/namespace1/authorizationRules/rule1",
+			ExpectError:           false,
+			ExpectedNamespaceName: "namespace1",
+			ExpectedRuleName:      "rule1",
+		},
+		{
+			Name:                     "HybridConnection Scoped Rule",
+			Input:                    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Relay/namespaces/namespace1/hybridConnections/relay1/authorizationRules/rule1",
+			ExpectError:              false,
+			ExpectedNamespaceName:    "namespace1",
+			ExpectedHybridConnection: "relay1",
+			ExpectedRuleName:         "rule1",
+		},
+		{
+			Name:        "Wrong Resource Type",
+			Input:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			id, err := ParseAuthorizationRuleID(tc.Input)
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatalf("Expected an error parsing %q but got none", tc.Input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error parsing %q but got: %s", tc.Input, err)
+			}
+
+			if id.NamespaceName != tc.ExpectedNamespaceName {
+				t.Fatalf("Expected NamespaceName %q but got %q", tc.ExpectedNamespaceName, id.NamespaceName)
+			}
+			if id.HybridConnectionName != tc.ExpectedHybridConnection {
+				t.Fatalf("Expected HybridConnectionName %q but got %q", tc.ExpectedHybridConnection, id.HybridConnectionName)
+			}
+			if id.Name != tc.ExpectedRuleName {
+				t.Fatalf("Expected Name %q but got %q", tc.ExpectedRuleName, id.Name)
+			}
+		})
+	}
+}
+
+func TestValidateAuthorizationRuleID(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Input       string
+		ExpectError bool
+	}{
+		{
+			Name:        "Valid",
+			Input:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Relay/namespaces/namespace1/authorizationRules/rule1",
+			ExpectError: false,
+		},
+		{
+			Name:        "Invalid",
+			Input:       "not-a-resource-id",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := ValidateAuthorizationRuleID(tc.Input, "authorization_rule_id")
+			if tc.ExpectError && len(errors) == 0 {
+				t.Fatalf("Expected an error validating %q but got none", tc.Input)
+			}
+			if !tc.ExpectError && len(errors) > 0 {
+				t.Fatalf("Expected no error validating %q but got: %v", tc.Input, errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package web
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/relay"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmAppServiceHybridConnection() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmAppServiceHybridConnectionRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_service_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAppServiceName,
+			},
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+			"relay_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: relay.ValidateHybridConnectionID,
+			},
+			"namespace_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"relay_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"service_bus_namespace": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_bus_suffix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"send_key_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"relay_arm_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"primary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"secondary_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"primary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"secondary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmAppServiceHybridConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	appServiceName := d.Get("app_service_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	namespaceName := d.Get("namespace_name").(string)
+	relayName := d.Get("relay_name").(string)
+
+	if relayArmURI, ok := d.GetOk("relay_id"); ok {
+		relayId, err := relay.ParseHybridConnectionID(relayArmURI.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing relay ID %q: %s", relayArmURI, err)
+		}
+		namespaceName = relayId.NamespaceName
+		relayName = relayId.Name
+	}
+
+	if namespaceName == "" || relayName == "" {
+		return fmt.Errorf("either `relay_id` or both `namespace_name` and `relay_name` must be specified")
+	}
+
+	resp, err := client.GetHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("App Service Hybrid Connection (App Service %q / Namespace %q / Relay %q) was not found in Resource Group %q", appServiceName, namespaceName, relayName, resourceGroup)
+		}
+		return fmt.Errorf("Error making Read request on App Service Hybrid Connection (App Service %q / Namespace %q / Relay %q, Resource Group %q): %s", appServiceName, namespaceName, relayName, resourceGroup, err)
+	}
+
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("API returned an empty ID for App Service Hybrid Connection (App Service %q / Namespace %q / Relay %q, Resource Group %q)", appServiceName, namespaceName, relayName, resourceGroup)
+	}
+	d.SetId(*resp.ID)
+
+	d.Set("app_service_name", appServiceName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("namespace_name", namespaceName)
+	d.Set("relay_name", relayName)
+
+	if props := resp.HybridConnectionProperties; props != nil {
+		d.Set("hostname", props.Hostname)
+		d.Set("port", props.Port)
+		d.Set("service_bus_namespace", props.ServiceBusNamespace)
+		d.Set("service_bus_suffix", props.ServiceBusSuffix)
+		d.Set("send_key_name", props.SendKeyName)
+		d.Set("relay_arm_uri", props.RelayArmURI)
+	}
+
+	serviceBusNSClient := meta.(*clients.Client).ServiceBus.NamespacesClient
+	serviceBusNSctx, serviceBusNSCancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer serviceBusNSCancel()
+
+	accessKeys, err := serviceBusNSClient.ListKeys(serviceBusNSctx, resourceGroup, *resp.ServiceBusNamespace, *resp.SendKeyName)
+	if err != nil {
+		return fmt.Errorf("Error listing keys for Namespace %q (Resource Group %q): %s", *resp.ServiceBusNamespace, resourceGroup, err)
+	}
+
+	d.Set("primary_key", accessKeys.PrimaryKey)
+	d.Set("secondary_key", accessKeys.SecondaryKey)
+	d.Set("primary_connection_string", accessKeys.PrimaryConnectionString)
+	d.Set("secondary_connection_string", accessKeys.SecondaryConnectionString)
+
+	return nil
+}
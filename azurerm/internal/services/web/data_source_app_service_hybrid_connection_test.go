@@ -0,0 +1,42 @@
+package web_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+)
+
+func TestAccDataSourceAzureRMAppServiceHybridConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_app_service_hybrid_connection", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMAppServiceHybridConnection_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "hostname"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "port"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "primary_key"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "primary_connection_string"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMAppServiceHybridConnection_basic(data acceptance.TestData) string {
+	template := testAccAzureRMAppServiceHybridConnection_basic(data)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_app_service_hybrid_connection" "test" {
+  app_service_name    = azurerm_app_service_hybrid_connection.test.app_service_name
+  resource_group_name = azurerm_app_service_hybrid_connection.test.resource_group_name
+  relay_id            = azurerm_app_service_hybrid_connection.test.relay_id
+}
+`, template)
+}
@@ -0,0 +1,69 @@
+package web
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmAppServicePlanHybridConnectionLimits() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmAppServicePlanHybridConnectionLimitsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_service_plan_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+			"maximum": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"current": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmAppServicePlanHybridConnectionLimitsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicePlansClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	planId := d.Get("app_service_plan_id").(string)
+	id, err := azure.ParseAzureResourceID(planId)
+	if err != nil {
+		return fmt.Errorf("Error parsing App Service Plan ID %q: %s", planId, err)
+	}
+	resourceGroup := id.ResourceGroup
+	planName := id.Path["serverfarms"]
+
+	limits, err := client.GetHybridConnectionPlanLimit(ctx, resourceGroup, planName)
+	if err != nil {
+		if utils.ResponseWasNotFound(limits.Response) {
+			return fmt.Errorf("App Service Plan %q (Resource Group %q) was not found", planName, resourceGroup)
+		}
+		return fmt.Errorf("Error retrieving Hybrid Connection Plan Limit for App Service Plan %q (Resource Group %q): %s", planName, resourceGroup, err)
+	}
+
+	d.SetId(planId)
+
+	if props := limits.HybridConnectionLimitsProperties; props != nil {
+		d.Set("maximum", props.Maximum)
+		d.Set("current", props.Current)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package web_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+)
+
+func TestAccDataSourceAzureRMAppServicePlanHybridConnectionLimits_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_app_service_plan_hybrid_connection_limits", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMAppServicePlanHybridConnectionLimits_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "maximum"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "current"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMAppServicePlanHybridConnectionLimits_basic(data acceptance.TestData) string {
+	template := testAccAzureRMAppServiceHybridConnection_basic(data)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_app_service_plan_hybrid_connection_limits" "test" {
+  app_service_plan_id = azurerm_app_service_plan.test.id
+}
+`, template)
+}
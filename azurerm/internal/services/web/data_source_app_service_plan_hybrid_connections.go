@@ -0,0 +1,122 @@
+package web
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceArmAppServicePlanHybridConnections() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmAppServicePlanHybridConnectionsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_service_plan_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+			"namespace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"hybrid_connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"relay_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmAppServicePlanHybridConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicePlansClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	planId := d.Get("app_service_plan_id").(string)
+	namespaceName := d.Get("namespace_name").(string)
+
+	id, err := azure.ParseAzureResourceID(planId)
+	if err != nil {
+		return fmt.Errorf("Error parsing App Service Plan ID %q: %s", planId, err)
+	}
+	resourceGroup := id.ResourceGroup
+	planName := id.Path["serverfarms"]
+
+	relays, err := client.ListHybridConnections(ctx, resourceGroup, planName, namespaceName)
+	if err != nil {
+		return fmt.Errorf("Error listing Hybrid Connections for App Service Plan %q (Namespace %q, Resource Group %q): %s", planName, namespaceName, resourceGroup, err)
+	}
+
+	hybridConnections := make([]interface{}, 0)
+	for relays.NotDone() {
+		for _, relay := range relays.Values() {
+			if relay.Name == nil {
+				continue
+			}
+			relayName := *relay.Name
+
+			sites, err := client.ListWebAppsByHybridConnection(ctx, resourceGroup, planName, namespaceName, relayName)
+			if err != nil {
+				return fmt.Errorf("Error listing Web Apps for Hybrid Connection %q (Namespace %q, App Service Plan %q, Resource Group %q): %s", relayName, namespaceName, planName, resourceGroup, err)
+			}
+
+			for sites.NotDone() {
+				for _, site := range sites.Values() {
+					connection := map[string]interface{}{
+						"app_service_name": site.Name,
+					}
+					if props := relay.HybridConnectionProperties; props != nil {
+						connection["relay_id"] = props.RelayArmURI
+						connection["hostname"] = props.Hostname
+						connection["port"] = props.Port
+					}
+					hybridConnections = append(hybridConnections, connection)
+				}
+
+				if err := sites.NextWithContext(ctx); err != nil {
+					return fmt.Errorf("Error paging Web Apps for Hybrid Connection %q (Namespace %q, App Service Plan %q, Resource Group %q): %s", relayName, namespaceName, planName, resourceGroup, err)
+				}
+			}
+		}
+
+		if err := relays.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error paging Hybrid Connections for App Service Plan %q (Namespace %q, Resource Group %q): %s", planName, namespaceName, resourceGroup, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/hybridConnectionNamespaces/%s", planId, namespaceName))
+	d.Set("hybrid_connections", hybridConnections)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package web_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+)
+
+func TestAccDataSourceAzureRMAppServicePlanHybridConnections_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_app_service_plan_hybrid_connections", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMAppServicePlanHybridConnections_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(data.ResourceName, "hybrid_connections.#", "1"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "hybrid_connections.0.app_service_name"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "hybrid_connections.0.hostname"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMAppServicePlanHybridConnections_basic(data acceptance.TestData) string {
+	template := testAccAzureRMAppServiceHybridConnection_basic(data)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_app_service_plan_hybrid_connections" "test" {
+  app_service_plan_id = azurerm_app_service_plan.test.id
+  namespace_name       = azurerm_relay_namespace.test.name
+
+  depends_on = [azurerm_app_service_hybrid_connection.test]
+}
+`, template)
+}
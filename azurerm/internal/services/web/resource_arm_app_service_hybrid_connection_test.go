@@ -0,0 +1,221 @@
+package web_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAppServiceHybridConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_hybrid_connection", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMAppServiceHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceHybridConnection_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceHybridConnectionExists(data.ResourceName),
+				),
+			},
+			data.ImportStep("send_key_value"),
+		},
+	})
+}
+
+func TestAccAzureRMAppServiceHybridConnection_authorizationRuleId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_hybrid_connection", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMAppServiceHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceHybridConnection_authorizationRuleId(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceHybridConnectionExists(data.ResourceName),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "primary_connection_string"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "secondary_key"),
+				),
+			},
+			data.ImportStep("authorization_rule_id", "key_rotation"),
+		},
+	})
+}
+
+func TestAccAzureRMAppServiceHybridConnection_requiresSendKeyOrAuthorizationRule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_hybrid_connection", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMAppServiceHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMAppServiceHybridConnection_noSendKey(data),
+				ExpectError: regexp.MustCompile("either `authorization_rule_id` or both `send_key_name` and `send_key_value` must be specified"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServiceHybridConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Hybrid Connection not found: %s", resourceName)
+		}
+
+		appServiceName := rs.Primary.Attributes["app_service_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		relayName := rs.Primary.Attributes["relay_name"]
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Web.AppServicesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		resp, err := client.GetHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on AppServicesClient: %+v", err)
+		}
+
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Bad: Hybrid Connection %q (App Service %q / Resource Group %q) does not exist", relayName, appServiceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAppServiceHybridConnectionDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Web.AppServicesClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service_hybrid_connection" {
+			continue
+		}
+
+		appServiceName := rs.Primary.Attributes["app_service_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		relayName := rs.Primary.Attributes["relay_name"]
+
+		resp, err := client.GetHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Hybrid Connection %q (App Service %q / Resource Group %q) still exists", relayName, appServiceName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMAppServiceHybridConnection_template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  app_service_plan_id = azurerm_app_service_plan.test.id
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Standard"
+}
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                 = "acctestrhc-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  relay_namespace_name = azurerm_relay_namespace.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func testAccAzureRMAppServiceHybridConnection_basic(data acceptance.TestData) string {
+	template := testAccAzureRMAppServiceHybridConnection_template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_hybrid_connection" "test" {
+  app_service_name    = azurerm_app_service.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  relay_id            = azurerm_relay_hybrid_connection.test.id
+  hostname            = "testwebapp.example.com"
+  port                = 443
+  send_key_name       = "RootManageSharedAccessKey"
+  send_key_value      = azurerm_relay_namespace.test.primary_key
+  service_bus_namespace = azurerm_relay_namespace.test.name
+}
+`, template)
+}
+
+func testAccAzureRMAppServiceHybridConnection_authorizationRuleId(data acceptance.TestData) string {
+	template := testAccAzureRMAppServiceHybridConnection_template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_hybrid_connection" "test" {
+  app_service_name      = azurerm_app_service.test.name
+  resource_group_name   = azurerm_resource_group.test.name
+  relay_id              = azurerm_relay_hybrid_connection.test.id
+  hostname              = "testwebapp.example.com"
+  port                  = 443
+  service_bus_namespace = azurerm_relay_namespace.test.name
+  authorization_rule_id = "${azurerm_relay_namespace.test.id}/authorizationRules/RootManageSharedAccessKey"
+  key_rotation          = "secondary"
+}
+`, template)
+}
+
+func testAccAzureRMAppServiceHybridConnection_noSendKey(data acceptance.TestData) string {
+	template := testAccAzureRMAppServiceHybridConnection_template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_hybrid_connection" "test" {
+  app_service_name      = azurerm_app_service.test.name
+  resource_group_name   = azurerm_resource_group.test.name
+  relay_id              = azurerm_relay_hybrid_connection.test.id
+  hostname              = "testwebapp.example.com"
+  port                  = 443
+  service_bus_namespace = azurerm_relay_namespace.test.name
+}
+`, template)
+}
@@ -0,0 +1,138 @@
+package web
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var functionAppHybridConnectionResourceConfig = hybridConnectionResourceConfig{
+	resourceType: "azurerm_function_app_hybrid_connection",
+	siteKind:     "Function App",
+}
+
+func resourceArmFunctionAppHybridConnection() *schema.Resource {
+	resource := &schema.Resource{
+		Create: resourceArmFunctionAppHybridConnectionCreateUpdate,
+		Read:   resourceArmFunctionAppHybridConnectionRead,
+		Update: resourceArmFunctionAppHybridConnectionCreateUpdate,
+		Delete: resourceArmFunctionAppHybridConnectionDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := ParseAppServiceHybridConnectionID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: hybridConnectionSchema(),
+
+		CustomizeDiff: hybridConnectionValidateSendKey,
+	}
+
+	resource.Schema["function_app_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: azure.ValidateResourceID,
+	}
+
+	return resource
+}
+
+// parseFunctionAppID validates that `id` is a Microsoft.Web/sites resource ID
+// and returns its site name and resource group. It does not call out to Azure,
+// so it can be unit tested on its own.
+func parseFunctionAppID(id string) (siteName string, resourceGroup string, err error) {
+	parsed, err := azure.ParseAzureResourceID(id)
+	if err != nil {
+		return "", "", fmt.Errorf("Error parsing Function App ID %q: %s", id, err)
+	}
+
+	if !strings.EqualFold(parsed.Provider, "Microsoft.Web") {
+		return "", "", fmt.Errorf("%q is not a valid Function App ID: expected provider `Microsoft.Web`", id)
+	}
+
+	siteName, ok := parsed.Path["sites"]
+	if !ok || siteName == "" {
+		return "", "", fmt.Errorf("%q is not a valid Function App ID: missing `sites` segment", id)
+	}
+
+	return siteName, parsed.ResourceGroup, nil
+}
+
+// getFunctionAppSite calls the Sites API and confirms that `siteName` is a
+// Function App rather than a Web App, returning the site on success.
+func getFunctionAppSite(d *schema.ResourceData, meta interface{}, siteName, resourceGroup string) (*web.Site, error) {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	site, err := client.Get(ctx, resourceGroup, siteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(site.Response) {
+			return nil, fmt.Errorf("Function App %q (Resource Group %q) was not found", siteName, resourceGroup)
+		}
+		return nil, fmt.Errorf("Error retrieving Function App %q (Resource Group %q): %s", siteName, resourceGroup, err)
+	}
+
+	if site.Kind == nil || !strings.Contains(strings.ToLower(*site.Kind), "functionapp") {
+		return nil, fmt.Errorf("%q (Resource Group %q) is not a Function App", siteName, resourceGroup)
+	}
+
+	return &site, nil
+}
+
+func resourceArmFunctionAppHybridConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	functionAppId := d.Get("function_app_id").(string)
+	siteName, resourceGroup, err := parseFunctionAppID(functionAppId)
+	if err != nil {
+		return err
+	}
+
+	if _, err := getFunctionAppSite(d, meta, siteName, resourceGroup); err != nil {
+		return err
+	}
+
+	if err := hybridConnectionCreateUpdate(d, meta, functionAppHybridConnectionResourceConfig, siteName, resourceGroup); err != nil {
+		return err
+	}
+
+	return resourceArmFunctionAppHybridConnectionRead(d, meta)
+}
+
+func resourceArmFunctionAppHybridConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	siteName, resourceGroup, err := hybridConnectionRead(d, meta, functionAppHybridConnectionResourceConfig)
+	if err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+
+	site, err := getFunctionAppSite(d, meta, siteName, resourceGroup)
+	if err != nil {
+		return err
+	}
+
+	d.Set("function_app_id", site.ID)
+
+	return nil
+}
+
+func resourceArmFunctionAppHybridConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	return hybridConnectionDelete(d, meta, functionAppHybridConnectionResourceConfig)
+}
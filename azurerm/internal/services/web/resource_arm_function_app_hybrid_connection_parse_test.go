@@ -0,0 +1,54 @@
+package web
+
+import "testing"
+
+func TestParseFunctionAppID(t *testing.T) {
+	cases := []struct {
+		Name                  string
+		Input                 string
+		ExpectError           bool
+		ExpectedSiteName      string
+		ExpectedResourceGroup string
+	}{
+		{
+			Name:        "Empty",
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Name:                  "Valid",
+			Input:                 "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Web/sites/site1",
+			ExpectError:           false,
+			ExpectedSiteName:      "site1",
+			ExpectedResourceGroup: "group1",
+		},
+		{
+			Name:        "Missing Sites Segment",
+			Input:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Web/serverfarms/plan1",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			siteName, resourceGroup, err := parseFunctionAppID(tc.Input)
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatalf("Expected an error parsing %q but got none", tc.Input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error parsing %q but got: %s", tc.Input, err)
+			}
+
+			if siteName != tc.ExpectedSiteName {
+				t.Fatalf("Expected site name %q but got %q", tc.ExpectedSiteName, siteName)
+			}
+			if resourceGroup != tc.ExpectedResourceGroup {
+				t.Fatalf("Expected resource group %q but got %q", tc.ExpectedResourceGroup, resourceGroup)
+			}
+		})
+	}
+}
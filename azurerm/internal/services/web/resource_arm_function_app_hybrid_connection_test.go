@@ -0,0 +1,161 @@
+package web_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMFunctionAppHybridConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_hybrid_connection", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMFunctionAppHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFunctionAppHybridConnection_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFunctionAppHybridConnectionExists(data.ResourceName),
+				),
+			},
+			data.ImportStep("send_key_value"),
+		},
+	})
+}
+
+func testCheckAzureRMFunctionAppHybridConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Hybrid Connection not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		siteName := id.Path["sites"]
+		namespaceName := id.Path["hybridConnectionNamespaces"]
+		relayName := id.Path["relays"]
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Web.AppServicesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		resp, err := client.GetHybridConnection(ctx, resourceGroup, siteName, namespaceName, relayName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on AppServicesClient: %+v", err)
+		}
+
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Bad: Hybrid Connection %q (Function App %q / Resource Group %q) does not exist", relayName, siteName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMFunctionAppHybridConnectionDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Web.AppServicesClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_function_app_hybrid_connection" {
+			continue
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		siteName := id.Path["sites"]
+		namespaceName := id.Path["hybridConnectionNamespaces"]
+		relayName := id.Path["relays"]
+
+		resp, err := client.GetHybridConnection(ctx, resourceGroup, siteName, namespaceName, relayName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Hybrid Connection %q (Function App %q / Resource Group %q) still exists", relayName, siteName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMFunctionAppHybridConnection_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  kind                = "FunctionApp"
+
+  sku {
+    tier = "Dynamic"
+    size = "Y1"
+  }
+}
+
+resource "azurerm_function_app" "test" {
+  name                       = "acctestFA-%d"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  app_service_plan_id        = azurerm_app_service_plan.test.id
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Standard"
+}
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                 = "acctestrhc-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  relay_namespace_name = azurerm_relay_namespace.test.name
+}
+
+resource "azurerm_function_app_hybrid_connection" "test" {
+  function_app_id       = azurerm_function_app.test.id
+  relay_id              = azurerm_relay_hybrid_connection.test.id
+  hostname              = "testfunctionapp.example.com"
+  port                  = 443
+  send_key_name         = "RootManageSharedAccessKey"
+  send_key_value        = azurerm_relay_namespace.test.primary_key
+  service_bus_namespace = azurerm_relay_namespace.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
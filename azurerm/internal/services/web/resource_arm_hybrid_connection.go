@@ -0,0 +1,337 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/relay"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	hybridConnectionKeyRotationPrimary   = "primary"
+	hybridConnectionKeyRotationSecondary = "secondary"
+)
+
+// hybridConnectionResourceConfig carries the bits that differ between the
+// Web App and Function App hybrid connection resources so the Create/Read/
+// Delete bodies below can stay in lock-step for both.
+type hybridConnectionResourceConfig struct {
+	resourceType string // e.g. "azurerm_app_service_hybrid_connection"
+	siteKind     string // human readable label used in error messages, e.g. "App Service"
+}
+
+// hybridConnectionSchema returns the schema shared by both the Web App and
+// Function App hybrid connection resources. Callers add their own
+// site-identifying field(s) (e.g. `app_service_name` or `function_app_id`).
+func hybridConnectionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"relay_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: relay.ValidateHybridConnectionID,
+		},
+		"hostname": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+		"port": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validate.PortNumberOrZero,
+		},
+		"service_bus_namespace": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{0,100}[a-zA-Z0-9]$"),
+				"The namespace can contain only letters, numbers, and hyphens. The namespace must start with a letter, and it must end with a letter or number.",
+			),
+		},
+		"service_bus_suffix": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      ".servicebus.windows.net",
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+		"send_key_name": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ValidateFunc:  validate.NoEmptyStrings,
+			ConflictsWith: []string{"authorization_rule_id"},
+		},
+		"send_key_value": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			Sensitive:     true,
+			ValidateFunc:  validate.NoEmptyStrings,
+			ConflictsWith: []string{"authorization_rule_id"},
+		},
+		"authorization_rule_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ValidateFunc:  relay.ValidateAuthorizationRuleID,
+			ConflictsWith: []string{"send_key_name", "send_key_value"},
+		},
+		"key_rotation": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      hybridConnectionKeyRotationPrimary,
+			ValidateFunc: validation.StringInSlice([]string{hybridConnectionKeyRotationPrimary, hybridConnectionKeyRotationSecondary}, false),
+		},
+		"primary_connection_string": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"secondary_connection_string": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"secondary_key": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"namespace_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"relay_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// hybridConnectionValidateSendKey ensures the user supplied either
+// `authorization_rule_id` or both `send_key_name` and `send_key_value`, rather
+// than letting an empty SendKeyName/SendKeyValue reach the Web Apps API.
+func hybridConnectionValidateSendKey(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("authorization_rule_id").(string) != "" {
+		return nil
+	}
+
+	if d.Get("send_key_name").(string) == "" || d.Get("send_key_value").(string) == "" {
+		return fmt.Errorf("either `authorization_rule_id` or both `send_key_name` and `send_key_value` must be specified")
+	}
+
+	return nil
+}
+
+// hybridConnectionCreateUpdate creates or updates the hybrid connection for the
+// site (Web App or Function App) named by siteName/resourceGroup.
+func hybridConnectionCreateUpdate(d *schema.ResourceData, meta interface{}, cfg hybridConnectionResourceConfig, siteName, resourceGroup string) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	relayArmURI := d.Get("relay_id").(string)
+	relayId, err := relay.ParseHybridConnectionID(relayArmURI)
+	if err != nil {
+		return fmt.Errorf("Error parsing relay ID %q: %s", relayArmURI, err)
+	}
+	namespaceName := relayId.NamespaceName
+	relayName := relayId.Name
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.GetHybridConnection(ctx, resourceGroup, siteName, namespaceName, relayName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing %s Hybrid Connection %q (Resource Group %q, Namespace %q, Relay Name %q): %s", cfg.siteKind, siteName, resourceGroup, namespaceName, relayName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError(cfg.resourceType, *existing.ID)
+		}
+	}
+
+	port := int32(d.Get("port").(int))
+
+	sendKeyName := d.Get("send_key_name").(string)
+	sendKeyValue := d.Get("send_key_value").(string)
+
+	if authRuleId := d.Get("authorization_rule_id").(string); authRuleId != "" {
+		keys, err := resolveHybridConnectionAuthorizationRuleKeys(ctx, meta, authRuleId)
+		if err != nil {
+			return fmt.Errorf("Error resolving Authorization Rule %q: %s", authRuleId, err)
+		}
+
+		sendKeyName = keys.keyName
+		sendKeyValue = keys.primaryKey
+		if d.Get("key_rotation").(string) == hybridConnectionKeyRotationSecondary {
+			sendKeyValue = keys.secondaryKey
+		}
+	}
+
+	connectionEnvelope := web.HybridConnection{
+		HybridConnectionProperties: &web.HybridConnectionProperties{
+			ServiceBusNamespace: utils.String(d.Get("service_bus_namespace").(string)),
+			RelayName:           &relayName,
+			RelayArmURI:         &relayArmURI,
+			Hostname:            utils.String(d.Get("hostname").(string)),
+			Port:                &port,
+			SendKeyName:         utils.String(sendKeyName),
+			SendKeyValue:        utils.String(sendKeyValue),
+			ServiceBusSuffix:    utils.String(d.Get("service_bus_suffix").(string)),
+		},
+	}
+
+	hybridConnection, err := client.CreateOrUpdateHybridConnection(ctx, resourceGroup, siteName, namespaceName, relayName, connectionEnvelope)
+	if err != nil {
+		return fmt.Errorf("Error creating %s Hybrid Connection %q (resource group %q): %s", cfg.siteKind, siteName, resourceGroup, err)
+	}
+
+	d.SetId(*hybridConnection.ID)
+	return nil
+}
+
+// hybridConnectionRead reads the hybrid connection identified by d.Id() and
+// populates the attributes of hybridConnectionSchema() from the API; the key
+// attributes are set only when ListKeys succeeds, and `authorization_rule_id`
+// and `key_rotation` are not read back. It returns the site
+// name and resource group parsed from the ID so that callers can set their own
+// site-identifying field(s).
+func hybridConnectionRead(d *schema.ResourceData, meta interface{}, cfg hybridConnectionResourceConfig) (siteName string, resourceGroup string, err error) {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return "", "", err
+	}
+	resourceGroup = id.ResourceGroup
+	siteName = id.Path["sites"]
+	namespaceName := id.Path["hybridConnectionNamespaces"]
+	relayName := id.Path["relays"]
+
+	resp, err := client.GetHybridConnection(ctx, resourceGroup, siteName, namespaceName, relayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return siteName, resourceGroup, nil
+		}
+		return "", "", fmt.Errorf("Error making Read request on %s Hybrid Connection %q in Namespace %q, Resource Group %q: %s", cfg.siteKind, siteName, namespaceName, resourceGroup, err)
+	}
+	d.Set("namespace_name", namespaceName)
+	d.Set("relay_name", relayName)
+
+	if props := resp.HybridConnectionProperties; props != nil {
+		d.Set("port", resp.Port)
+		d.Set("service_bus_namespace", resp.ServiceBusNamespace)
+		d.Set("send_key_name", resp.SendKeyName)
+		d.Set("service_bus_suffix", resp.ServiceBusSuffix)
+		d.Set("relay_id", resp.RelayArmURI)
+		d.Set("hostname", resp.Hostname)
+	}
+	// key values are not returned in the response, so we get the primary key from the Service Bus ListKeys func
+	serviceBusNSClient := meta.(*clients.Client).ServiceBus.NamespacesClient
+	serviceBusNSctx, serviceBusNSCancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer serviceBusNSCancel()
+
+	accessKeys, err := serviceBusNSClient.ListKeys(serviceBusNSctx, resourceGroup, *resp.ServiceBusNamespace, *resp.SendKeyName)
+	if err != nil {
+		log.Printf("[WARN] Unable to List default keys for Namespace %q (Resource Group %q): %+v", siteName, resourceGroup, err)
+	} else {
+		d.Set("send_key_value", accessKeys.PrimaryKey)
+		d.Set("secondary_key", accessKeys.SecondaryKey)
+		d.Set("primary_connection_string", accessKeys.PrimaryConnectionString)
+		d.Set("secondary_connection_string", accessKeys.SecondaryConnectionString)
+	}
+
+	return siteName, resourceGroup, nil
+}
+
+// hybridConnectionDelete deletes the hybrid connection identified by d.Id().
+func hybridConnectionDelete(d *schema.ResourceData, meta interface{}, cfg hybridConnectionResourceConfig) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	siteName := id.Path["sites"]
+	namespaceName := id.Path["hybridConnectionNamespaces"]
+	relayName := id.Path["relays"]
+
+	resp, err := client.DeleteHybridConnection(ctx, resourceGroup, siteName, namespaceName, relayName)
+	if err != nil {
+		if response.WasNotFound(resp.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting %s Hybrid Connection %q (Resource Group %q, Relay %q): %+v", cfg.siteKind, siteName, resourceGroup, relayName, err)
+	}
+
+	return nil
+}
+
+type hybridConnectionAuthorizationRuleKeys struct {
+	keyName      string
+	primaryKey   string
+	secondaryKey string
+}
+
+// resolveHybridConnectionAuthorizationRuleKeys accepts the Resource ID of either a
+// Relay HybridConnection-scoped or a Relay Namespace-scoped authorization rule and
+// resolves the key name/values via the appropriate Relay ListKeys API.
+func resolveHybridConnectionAuthorizationRuleKeys(ctx context.Context, meta interface{}, authorizationRuleId string) (*hybridConnectionAuthorizationRuleKeys, error) {
+	id, err := relay.ParseAuthorizationRuleID(authorizationRuleId)
+	if err != nil {
+		return nil, err
+	}
+
+	if id.HybridConnectionName != "" {
+		client := meta.(*clients.Client).Relay.HybridConnectionsClient
+		keys, err := client.ListKeys(ctx, id.ResourceGroup, id.NamespaceName, id.HybridConnectionName, id.Name)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing keys for Hybrid Connection %q Authorization Rule %q (Namespace %q / Resource Group %q): %s", id.HybridConnectionName, id.Name, id.NamespaceName, id.ResourceGroup, err)
+		}
+
+		if keys.PrimaryKey == nil || keys.SecondaryKey == nil {
+			return nil, fmt.Errorf("Hybrid Connection %q Authorization Rule %q (Namespace %q / Resource Group %q) returned an empty primary or secondary key", id.HybridConnectionName, id.Name, id.NamespaceName, id.ResourceGroup)
+		}
+
+		return &hybridConnectionAuthorizationRuleKeys{
+			keyName:      id.Name,
+			primaryKey:   *keys.PrimaryKey,
+			secondaryKey: *keys.SecondaryKey,
+		}, nil
+	}
+
+	client := meta.(*clients.Client).Relay.NamespacesClient
+	keys, err := client.ListKeys(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing keys for Namespace %q Authorization Rule %q (Resource Group %q): %s", id.NamespaceName, id.Name, id.ResourceGroup, err)
+	}
+
+	if keys.PrimaryKey == nil || keys.SecondaryKey == nil {
+		return nil, fmt.Errorf("Namespace %q Authorization Rule %q (Resource Group %q) returned an empty primary or secondary key", id.NamespaceName, id.Name, id.ResourceGroup)
+	}
+
+	return &hybridConnectionAuthorizationRuleKeys{
+		keyName:      id.Name,
+		primaryKey:   *keys.PrimaryKey,
+		secondaryKey: *keys.SecondaryKey,
+	}, nil
+}